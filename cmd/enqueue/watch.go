@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"dump-tmdb/internal/job"
+	"dump-tmdb/internal/store"
+	"dump-tmdb/internal/tmdb"
+
+	"github.com/fsnotify/fsnotify"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// RecheckAge is how long ago a movie must have last been seen before a dataset
+// record with the same popularity is still considered changed, so we don't
+// starve titles we haven't refreshed in a while even when TMDB reports no
+// popularity movement.
+const RecheckAge = 24 * time.Hour
+
+// PopularityEpsilon is the smallest popularity delta worth re-enqueuing a
+// movie for; TMDB's popularity score jitters slightly between exports.
+const PopularityEpsilon = 0.01
+
+// watch follows dir for new TMDB daily export files (movie_ids_MM_DD_YYYY.json
+// or .json.gz) and enqueues only the IDs that are new or have changed since
+// they were last saved.
+func watch(ctx context.Context, dir string, queue *job.Queue, st store.Store, movies *mongo.Collection) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	if err := watcher.Add(dir); err != nil {
+		return err
+	}
+	fmt.Printf("Watching %s for new TMDB daily exports...\n", dir)
+
+	for {
+		select {
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if event.Op&(fsnotify.Create|fsnotify.Write) == 0 {
+				continue
+			}
+			if !isDatasetFile(event.Name) {
+				continue
+			}
+			fmt.Printf("Detected new export: %s\n", event.Name)
+			if err := enqueueFile(ctx, event.Name, queue, st, movies); err != nil {
+				fmt.Printf("[Error] processing %s --> %s\n", event.Name, err.Error())
+			}
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			fmt.Println("[Error] watcher:", err.Error())
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func isDatasetFile(name string) bool {
+	base := filepath.Base(name)
+	matched, _ := filepath.Match("movie_ids_*_*_*.json", base)
+	if matched {
+		return true
+	}
+	matched, _ = filepath.Match("movie_ids_*_*_*.json.gz", base)
+	return matched
+}
+
+// isNewOrChanged reports whether record is missing from the configured
+// store (isNew), or already saved but has a different popularity than
+// what's stored or hasn't been refreshed in RecheckAge (changed). Callers
+// use isNew to pick Queue.Enqueue over Queue.Requeue so a record that's
+// merely new doesn't reset the attempts/status of a job already in flight
+// for it. The popularity/last-seen refinement only applies when movies is
+// non-nil, since that data is specific to the mongo store's schema and
+// isn't something store.Store exposes for every backend.
+func isNewOrChanged(ctx context.Context, st store.Store, movies *mongo.Collection, record tmdb.DatasetRecord) (isNew bool, changed bool, err error) {
+	has, err := st.Has(ctx, "movie", record.ID)
+	if err != nil {
+		return false, false, err
+	}
+	if !has {
+		return true, true, nil
+	}
+	if movies == nil {
+		return false, false, nil
+	}
+
+	var existing struct {
+		Popularity float64   `bson:"popularity"`
+		LastSeen   time.Time `bson:"last_seen"`
+	}
+	err = movies.FindOne(ctx, bson.M{"id": record.ID}).Decode(&existing)
+	if err == mongo.ErrNoDocuments {
+		return true, true, nil
+	}
+	if err != nil {
+		return false, false, err
+	}
+	popularityDelta := record.Popularity - existing.Popularity
+	if popularityDelta < 0 {
+		popularityDelta = -popularityDelta
+	}
+	if popularityDelta > PopularityEpsilon {
+		return false, true, nil
+	}
+	return false, time.Since(existing.LastSeen) > RecheckAge, nil
+}
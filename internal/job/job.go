@@ -0,0 +1,147 @@
+// Package job implements a durable job queue on top of a MongoDB collection
+// so dumps can be resumed across crashes and worked on by multiple worker
+// processes at once.
+package job
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	StatusPending    = "pending"
+	StatusInProgress = "in_progress"
+	StatusDone       = "done"
+	StatusFailed     = "failed"
+)
+
+// LeaseTimeout bounds how long a worker may hold a claimed job before another
+// worker is allowed to steal it back, in case the original worker crashed.
+const LeaseTimeout = 5 * time.Minute
+
+// MaxAttempts is the number of tries before a job is given up on and left in
+// StatusFailed instead of being re-enqueued.
+const MaxAttempts = 8
+
+// Job carries enough state for a worker to fetch and save one entity. Kind
+// identifies which Fetcher should handle it (e.g. "movie", "tv", "person",
+// "collection"); together with TmdbID it uniquely identifies the job, since
+// the same numeric ID can refer to unrelated entities across kinds.
+type Job struct {
+	Kind      string    `bson:"kind"`
+	TmdbID    int       `bson:"tmdb_id"`
+	Status    string    `bson:"status"`
+	Attempts  int       `bson:"attempts"`
+	NextRunAt time.Time `bson:"next_run_at"`
+	LastError string    `bson:"last_error"`
+	ClaimedAt time.Time `bson:"claimed_at,omitempty"`
+}
+
+type Queue struct {
+	collection *mongo.Collection
+}
+
+func NewQueue(collection *mongo.Collection) *Queue {
+	return &Queue{collection: collection}
+}
+
+// Enqueue inserts a pending job for (kind, tmdbID), or does nothing if one
+// already exists.
+func (q *Queue) Enqueue(ctx context.Context, kind string, tmdbID int) error {
+	_, err := q.collection.UpdateOne(ctx,
+		bson.M{"kind": kind, "tmdb_id": tmdbID},
+		bson.M{"$setOnInsert": Job{
+			Kind:      kind,
+			TmdbID:    tmdbID,
+			Status:    StatusPending,
+			NextRunAt: time.Now(),
+		}},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
+
+// Requeue inserts a pending job for (kind, tmdbID), or resets it back to
+// pending if one already exists in any status — including done or failed —
+// so a record TMDB reports changed gets picked up again instead of being
+// silently skipped by Enqueue.
+func (q *Queue) Requeue(ctx context.Context, kind string, tmdbID int) error {
+	_, err := q.collection.UpdateOne(ctx,
+		bson.M{"kind": kind, "tmdb_id": tmdbID},
+		bson.M{"$set": bson.M{
+			"kind":        kind,
+			"tmdb_id":     tmdbID,
+			"status":      StatusPending,
+			"attempts":    0,
+			"next_run_at": time.Now(),
+			"last_error":  "",
+		}},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
+
+// Claim atomically picks the next runnable job of one of kinds and marks it
+// in_progress, so concurrent workers never claim the same job twice and a
+// worker started with --entity=movie never claims a tv/person/collection
+// job it has no fetcher for. It returns nil, nil when there is no runnable
+// job of those kinds.
+func (q *Queue) Claim(ctx context.Context, kinds []string) (*Job, error) {
+	now := time.Now()
+	filter := bson.M{
+		"kind":        bson.M{"$in": kinds},
+		"status":      bson.M{"$in": bson.A{StatusPending, StatusInProgress}},
+		"next_run_at": bson.M{"$lte": now},
+		"$or": bson.A{
+			bson.M{"status": StatusPending},
+			bson.M{"claimed_at": bson.M{"$lte": now.Add(-LeaseTimeout)}},
+		},
+	}
+	update := bson.M{"$set": bson.M{"status": StatusInProgress, "claimed_at": now}}
+	result := q.collection.FindOneAndUpdate(ctx, filter, update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After))
+	var j Job
+	if err := result.Decode(&j); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &j, nil
+}
+
+// MarkDone records a job as successfully processed.
+func (q *Queue) MarkDone(ctx context.Context, kind string, tmdbID int) error {
+	_, err := q.collection.UpdateOne(ctx,
+		bson.M{"kind": kind, "tmdb_id": tmdbID},
+		bson.M{"$set": bson.M{"status": StatusDone, "last_error": ""}},
+	)
+	return err
+}
+
+// MarkFailed records the error and either re-enqueues the job after an
+// exponential backoff delay or gives up once MaxAttempts is reached.
+func (q *Queue) MarkFailed(ctx context.Context, kind string, tmdbID int, attempts int, cause error) error {
+	status := StatusPending
+	if attempts >= MaxAttempts {
+		status = StatusFailed
+	}
+	backoff := time.Duration(1<<attempts) * time.Second
+	if backoff > time.Hour {
+		backoff = time.Hour
+	}
+	_, err := q.collection.UpdateOne(ctx,
+		bson.M{"kind": kind, "tmdb_id": tmdbID},
+		bson.M{"$set": bson.M{
+			"status":      status,
+			"attempts":    attempts,
+			"next_run_at": time.Now().Add(backoff),
+			"last_error":  cause.Error(),
+		}},
+	)
+	return err
+}
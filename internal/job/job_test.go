@@ -0,0 +1,128 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func TestQueueClaim(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("returns the claimed job", func(mt *mtest.T) {
+		claimedAt := time.Now()
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "value", Value: bson.D{
+			{Key: "kind", Value: "movie"},
+			{Key: "tmdb_id", Value: 42},
+			{Key: "status", Value: StatusInProgress},
+			{Key: "attempts", Value: 1},
+			{Key: "next_run_at", Value: claimedAt},
+			{Key: "claimed_at", Value: claimedAt},
+		}}))
+
+		q := NewQueue(mt.Coll)
+		j, err := q.Claim(context.Background(), []string{"movie"})
+		if err != nil {
+			t.Fatalf("Claim returned error: %v", err)
+		}
+		if j == nil {
+			t.Fatal("Claim returned nil job, want the mocked job")
+		}
+		if j.Kind != "movie" || j.TmdbID != 42 || j.Status != StatusInProgress {
+			t.Fatalf("Claim returned %+v, want kind=movie tmdb_id=42 status=%s", j, StatusInProgress)
+		}
+	})
+
+	mt.Run("returns nil when nothing is runnable", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "value", Value: nil}))
+
+		q := NewQueue(mt.Coll)
+		j, err := q.Claim(context.Background(), []string{"movie"})
+		if err != nil {
+			t.Fatalf("Claim returned error: %v", err)
+		}
+		if j != nil {
+			t.Fatalf("Claim returned %+v, want nil", j)
+		}
+	})
+}
+
+func TestQueueMarkFailed(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("re-enqueues with backoff below MaxAttempts", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}))
+
+		q := NewQueue(mt.Coll)
+		before := time.Now()
+		if err := q.MarkFailed(context.Background(), "movie", 42, 3, errors.New("boom")); err != nil {
+			t.Fatalf("MarkFailed returned error: %v", err)
+		}
+
+		update := decodeUpdate(t, mt)
+		if update.Status != StatusPending {
+			t.Fatalf("status = %q, want %q", update.Status, StatusPending)
+		}
+		wantBackoff := 8 * time.Second // 1 << 3
+		tolerance := time.Second
+		if gotBackoff := update.NextRunAt.Sub(before); gotBackoff < wantBackoff-tolerance || gotBackoff > wantBackoff+tolerance {
+			t.Fatalf("next_run_at backoff = %v, want ~%v", gotBackoff, wantBackoff)
+		}
+	})
+
+	mt.Run("gives up at MaxAttempts", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}))
+
+		q := NewQueue(mt.Coll)
+		if err := q.MarkFailed(context.Background(), "movie", 42, MaxAttempts, errors.New("boom")); err != nil {
+			t.Fatalf("MarkFailed returned error: %v", err)
+		}
+
+		update := decodeUpdate(t, mt)
+		if update.Status != StatusFailed {
+			t.Fatalf("status = %q, want %q", update.Status, StatusFailed)
+		}
+	})
+}
+
+// decodeUpdate extracts the $set document from the most recent update
+// command mt observed, so tests can assert on what MarkFailed actually sent
+// without a real server to query back.
+func decodeUpdate(t *testing.T, mt *mtest.T) struct {
+	Status    string    `bson:"status"`
+	NextRunAt time.Time `bson:"next_run_at"`
+} {
+	t.Helper()
+	started := mt.GetStartedEvent()
+	if started == nil {
+		t.Fatal("no command was started")
+	}
+	updates, ok := started.Command.Lookup("updates").ArrayOK()
+	if !ok {
+		t.Fatalf("command %v has no updates array", started.Command)
+	}
+	values, err := updates.Values()
+	if err != nil || len(values) == 0 {
+		t.Fatalf("could not read updates array: %v", err)
+	}
+	updateDoc, ok := values[0].DocumentOK()
+	if !ok {
+		t.Fatal("update entry is not a document")
+	}
+	setDoc, ok := updateDoc.Lookup("u", "$set").DocumentOK()
+	if !ok {
+		t.Fatalf("update %v has no u.$set", updateDoc)
+	}
+	var out struct {
+		Status    string    `bson:"status"`
+		NextRunAt time.Time `bson:"next_run_at"`
+	}
+	if err := bson.Unmarshal(setDoc, &out); err != nil {
+		t.Fatalf("could not decode $set: %v", err)
+	}
+	return out
+}
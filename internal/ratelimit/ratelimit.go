@@ -0,0 +1,54 @@
+// Package ratelimit wraps golang.org/x/time/rate with the ability to pause
+// the limiter entirely for a fixed duration, so a 429 response can honor
+// TMDB's Retry-After header instead of just sleeping in the calling
+// goroutine.
+package ratelimit
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+type Limiter struct {
+	*rate.Limiter
+	mu         sync.Mutex
+	normal     rate.Limit
+	pauseUntil time.Time
+}
+
+// New returns a limiter allowing requestsPerSecond sustained, with bursts up
+// to burst.
+func New(requestsPerSecond float64, burst int) *Limiter {
+	limit := rate.Limit(requestsPerSecond)
+	return &Limiter{
+		Limiter: rate.NewLimiter(limit, burst),
+		normal:  limit,
+	}
+}
+
+// PauseFor stops the limiter from handing out any more tokens for d, then
+// restores the configured rate. Call this after a 429 with the duration
+// parsed from the Retry-After header.
+//
+// Overlapping calls are common when several goroutines hit 429s around the
+// same time with different Retry-After values; only the latest deadline
+// wins, so a short pause scheduled after a longer one can't prematurely
+// restore the rate while the longer pause is still meant to hold.
+func (l *Limiter) PauseFor(d time.Duration) {
+	l.mu.Lock()
+	deadline := time.Now().Add(d)
+	if deadline.After(l.pauseUntil) {
+		l.pauseUntil = deadline
+	}
+	l.SetLimit(0)
+	l.mu.Unlock()
+	time.AfterFunc(d, func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		if !time.Now().Before(l.pauseUntil) {
+			l.SetLimit(l.normal)
+		}
+	})
+}
@@ -0,0 +1,60 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"dump-tmdb/internal/tmdb"
+)
+
+// fileStore is the local json-files/ layout that used to be commented out in
+// saveMovie, kept as a backend for people who don't want to run a database.
+// Each kind gets its own subdirectory.
+type fileStore struct {
+	dir string
+}
+
+func newFileStore(cfg Config) (Store, error) {
+	dir := cfg.FileDir
+	if dir == "" {
+		dir = "json-files"
+	}
+	return &fileStore{dir: dir}, nil
+}
+
+func (s *fileStore) path(kind string, id int) string {
+	return filepath.Join(s.dir, kind, fmt.Sprintf("%d.json", id))
+}
+
+func (s *fileStore) Save(ctx context.Context, record tmdb.Record) error {
+	path := s.path(record.RecordKind(), record.RecordID())
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(record)
+}
+
+func (s *fileStore) Has(ctx context.Context, kind string, id int) (bool, error) {
+	_, err := os.Stat(s.path(kind, id))
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *fileStore) Close() error {
+	return nil
+}
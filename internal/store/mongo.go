@@ -0,0 +1,170 @@
+package store
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"dump-tmdb/internal/tmdb"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// mongoFlushInterval bounds how long a partially-filled batch waits before
+// being written anyway, so a trickle of saves near the end of a run doesn't
+// stall behind an unfilled batch. It's a backstop only: mongoIdleFlushDelay
+// is what actually bounds Save latency under low concurrency.
+const mongoFlushInterval = 5 * time.Second
+
+// mongoIdleFlushDelay is how long a batch waits after its first write before
+// being flushed even if it never fills, so Save latency stays sub-second
+// regardless of how many goroutines are feeding the batch. Without this, a
+// batch only flushes when batchSize is reached or mongoFlushInterval ticks,
+// which at low worker concurrency means every Save blocks for seconds.
+const mongoIdleFlushDelay = 200 * time.Millisecond
+
+type mongoPendingWrite struct {
+	record tmdb.Record
+	result chan error
+}
+
+// mongoStore upserts records keyed on RecordID via BulkWrite, batched per
+// kind in groups of batchSize, so re-running a dump is idempotent and a full
+// dump of ~900k TMDB IDs isn't 900k separate round-trips. Each kind is kept
+// in its own collection (movie, tv, person, collection by default).
+type mongoStore struct {
+	client    *mongo.Client
+	database  *mongo.Database
+	batchSize int
+
+	// movieCollection overrides the collection name for the "movie" kind, to
+	// preserve the pre-existing MONGODB_COLLECTION name for data saved before
+	// other entities were supported.
+	movieCollection string
+
+	mu      sync.Mutex
+	pending map[string][]mongoPendingWrite
+	timers  map[string]*time.Timer
+	stop    chan struct{}
+}
+
+func newMongoStore(ctx context.Context, cfg Config) (Store, error) {
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(cfg.MongoURI))
+	if err != nil {
+		return nil, err
+	}
+	batchSize := cfg.MongoBatchSize
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+	s := &mongoStore{
+		client:          client,
+		database:        client.Database(cfg.MongoDatabase),
+		batchSize:       batchSize,
+		movieCollection: cfg.MongoCollection,
+		pending:         make(map[string][]mongoPendingWrite),
+		timers:          make(map[string]*time.Timer),
+		stop:            make(chan struct{}),
+	}
+	go s.flushLoop()
+	return s, nil
+}
+
+func (s *mongoStore) collectionFor(kind string) *mongo.Collection {
+	if kind == "movie" && s.movieCollection != "" {
+		return s.database.Collection(s.movieCollection)
+	}
+	return s.database.Collection(kind)
+}
+
+func (s *mongoStore) flushLoop() {
+	ticker := time.NewTicker(mongoFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.flushAllPending(context.Background())
+		case <-s.stop:
+			return
+		}
+	}
+}
+
+func (s *mongoStore) flushAllPending(ctx context.Context) {
+	s.mu.Lock()
+	kinds := make([]string, 0, len(s.pending))
+	for kind := range s.pending {
+		kinds = append(kinds, kind)
+	}
+	s.mu.Unlock()
+	for _, kind := range kinds {
+		s.flushPending(ctx, kind)
+	}
+}
+
+func (s *mongoStore) flushPending(ctx context.Context, kind string) {
+	s.mu.Lock()
+	batch := s.pending[kind]
+	delete(s.pending, kind)
+	if t, ok := s.timers[kind]; ok {
+		t.Stop()
+		delete(s.timers, kind)
+	}
+	s.mu.Unlock()
+	if len(batch) == 0 {
+		return
+	}
+	models := make([]mongo.WriteModel, len(batch))
+	for i, p := range batch {
+		models[i] = mongo.NewReplaceOneModel().
+			SetFilter(bson.M{"id": p.record.RecordID()}).
+			SetReplacement(p.record).
+			SetUpsert(true)
+	}
+	_, err := s.collectionFor(kind).BulkWrite(ctx, models)
+	for _, p := range batch {
+		p.result <- err
+	}
+}
+
+func (s *mongoStore) Save(ctx context.Context, record tmdb.Record) error {
+	kind := record.RecordKind()
+	result := make(chan error, 1)
+	s.mu.Lock()
+	s.pending[kind] = append(s.pending[kind], mongoPendingWrite{record: record, result: result})
+	full := len(s.pending[kind]) >= s.batchSize
+	if !full && s.timers[kind] == nil {
+		s.timers[kind] = time.AfterFunc(mongoIdleFlushDelay, func() {
+			s.flushPending(context.Background(), kind)
+		})
+	}
+	s.mu.Unlock()
+	if full {
+		s.flushPending(ctx, kind)
+	}
+	select {
+	case err := <-result:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *mongoStore) Has(ctx context.Context, kind string, id int) (bool, error) {
+	err := s.collectionFor(kind).FindOne(ctx, bson.M{"id": id}).Err()
+	if err == mongo.ErrNoDocuments {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *mongoStore) Close() error {
+	close(s.stop)
+	s.flushAllPending(context.Background())
+	return s.client.Disconnect(context.Background())
+}
@@ -0,0 +1,98 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"dump-tmdb/internal/tmdb"
+
+	_ "github.com/lib/pq"
+)
+
+// postgresTables maps a record kind to its table name and the CREATE TABLE
+// statement that backs it. Every table stores the full record as JSONB plus
+// generated columns for the fields worth indexing or querying directly.
+var postgresTables = map[string]struct {
+	table  string
+	schema string
+}{
+	"movie": {"movies", `
+CREATE TABLE IF NOT EXISTS movies (
+	data JSONB NOT NULL,
+	id INTEGER GENERATED ALWAYS AS ((data->>'id')::int) STORED PRIMARY KEY,
+	title TEXT GENERATED ALWAYS AS (data->>'title') STORED,
+	release_date TEXT GENERATED ALWAYS AS (data->>'release_date') STORED,
+	popularity DOUBLE PRECISION GENERATED ALWAYS AS ((data->>'popularity')::double precision) STORED
+)`},
+	"tv": {"tv_series", `
+CREATE TABLE IF NOT EXISTS tv_series (
+	data JSONB NOT NULL,
+	id INTEGER GENERATED ALWAYS AS ((data->>'id')::int) STORED PRIMARY KEY,
+	name TEXT GENERATED ALWAYS AS (data->>'name') STORED,
+	popularity DOUBLE PRECISION GENERATED ALWAYS AS ((data->>'popularity')::double precision) STORED
+)`},
+	"person": {"people", `
+CREATE TABLE IF NOT EXISTS people (
+	data JSONB NOT NULL,
+	id INTEGER GENERATED ALWAYS AS ((data->>'id')::int) STORED PRIMARY KEY,
+	name TEXT GENERATED ALWAYS AS (data->>'name') STORED,
+	popularity DOUBLE PRECISION GENERATED ALWAYS AS ((data->>'popularity')::double precision) STORED
+)`},
+	"collection": {"collections", `
+CREATE TABLE IF NOT EXISTS collections (
+	data JSONB NOT NULL,
+	id INTEGER GENERATED ALWAYS AS ((data->>'id')::int) STORED PRIMARY KEY,
+	name TEXT GENERATED ALWAYS AS (data->>'name') STORED
+)`},
+}
+
+type postgresStore struct {
+	db *sql.DB
+}
+
+func newPostgresStore(ctx context.Context, cfg Config) (Store, error) {
+	db, err := sql.Open("postgres", cfg.PostgresConnString)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range postgresTables {
+		if _, err := db.ExecContext(ctx, t.schema); err != nil {
+			db.Close()
+			return nil, err
+		}
+	}
+	return &postgresStore{db: db}, nil
+}
+
+func (s *postgresStore) Save(ctx context.Context, record tmdb.Record) error {
+	t, ok := postgresTables[record.RecordKind()]
+	if !ok {
+		return fmt.Errorf("postgres store: unknown kind %q", record.RecordKind())
+	}
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx,
+		fmt.Sprintf(`INSERT INTO %s (data) VALUES ($1) ON CONFLICT (id) DO UPDATE SET data = EXCLUDED.data`, t.table),
+		data,
+	)
+	return err
+}
+
+func (s *postgresStore) Has(ctx context.Context, kind string, id int) (bool, error) {
+	t, ok := postgresTables[kind]
+	if !ok {
+		return false, fmt.Errorf("postgres store: unknown kind %q", kind)
+	}
+	var exists bool
+	err := s.db.QueryRowContext(ctx,
+		fmt.Sprintf(`SELECT EXISTS(SELECT 1 FROM %s WHERE id = $1)`, t.table), id).Scan(&exists)
+	return exists, err
+}
+
+func (s *postgresStore) Close() error {
+	return s.db.Close()
+}
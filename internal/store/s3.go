@@ -0,0 +1,81 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"dump-tmdb/internal/tmdb"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/smithy-go"
+)
+
+// s3Store writes one JSON object per record under {prefix}/{id}.json, where
+// prefix is the kind's entry in s3Prefixes (e.g. movies/123.json), useful for
+// archival dumps against any S3-compatible object storage.
+type s3Store struct {
+	client *s3.Client
+	bucket string
+}
+
+func newS3Store(ctx context.Context, cfg Config) (Store, error) {
+	awsCfg, err := awsconfig.LoadDefaultConfig(ctx, awsconfig.WithRegion(cfg.S3Region))
+	if err != nil {
+		return nil, err
+	}
+	client := s3.NewFromConfig(awsCfg, func(o *s3.Options) {
+		if cfg.S3Endpoint != "" {
+			o.BaseEndpoint = aws.String(cfg.S3Endpoint)
+		}
+	})
+	return &s3Store{client: client, bucket: cfg.S3Bucket}, nil
+}
+
+// s3Prefixes maps a record kind to its object key prefix.
+var s3Prefixes = map[string]string{
+	"movie":      "movies",
+	"tv":         "tv",
+	"person":     "people",
+	"collection": "collections",
+}
+
+func (s *s3Store) key(kind string, id int) string {
+	return fmt.Sprintf("%s/%d.json", s3Prefixes[kind], id)
+}
+
+func (s *s3Store) Save(ctx context.Context, record tmdb.Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	_, err = s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(record.RecordKind(), record.RecordID())),
+		Body:   bytes.NewReader(data),
+	})
+	return err
+}
+
+func (s *s3Store) Has(ctx context.Context, kind string, id int) (bool, error) {
+	_, err := s.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(kind, id)),
+	})
+	if err == nil {
+		return true, nil
+	}
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) && apiErr.ErrorCode() == "NotFound" {
+		return false, nil
+	}
+	return false, err
+}
+
+func (s *s3Store) Close() error {
+	return nil
+}
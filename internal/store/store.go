@@ -0,0 +1,54 @@
+// Package store decouples the fetch pipeline from any single database
+// choice. Pick a backend with --store=mongo|postgres|s3|file.
+package store
+
+import (
+	"context"
+	"fmt"
+
+	"dump-tmdb/internal/tmdb"
+)
+
+// Store persists tmdb.Records (movies, TV series, people, collections) and
+// lets callers check whether one has already been saved, regardless of
+// backend.
+type Store interface {
+	Save(ctx context.Context, record tmdb.Record) error
+	Has(ctx context.Context, kind string, id int) (bool, error)
+	Close() error
+}
+
+// Config carries the per-backend settings needed to open any of the Store
+// implementations. Only the fields relevant to --store are used.
+type Config struct {
+	Kind string // mongo, postgres, s3, or file
+
+	MongoURI        string
+	MongoDatabase   string
+	MongoCollection string
+	MongoBatchSize  int
+
+	PostgresConnString string
+
+	S3Bucket   string
+	S3Endpoint string
+	S3Region   string
+
+	FileDir string
+}
+
+// Open returns the Store named by cfg.Kind.
+func Open(ctx context.Context, cfg Config) (Store, error) {
+	switch cfg.Kind {
+	case "", "mongo":
+		return newMongoStore(ctx, cfg)
+	case "postgres":
+		return newPostgresStore(ctx, cfg)
+	case "s3":
+		return newS3Store(ctx, cfg)
+	case "file":
+		return newFileStore(cfg)
+	default:
+		return nil, fmt.Errorf("unknown store %q", cfg.Kind)
+	}
+}
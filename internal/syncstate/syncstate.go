@@ -0,0 +1,50 @@
+// Package syncstate persists the cursor an incremental sync resumed from, so
+// restarts pick up from the last successful end_date instead of rescanning
+// everything.
+package syncstate
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type cursor struct {
+	Kind    string    `bson:"kind"`
+	EndDate time.Time `bson:"end_date"`
+}
+
+type Store struct {
+	collection *mongo.Collection
+}
+
+func NewStore(collection *mongo.Collection) *Store {
+	return &Store{collection: collection}
+}
+
+// LastEndDate returns the end_date of the last successful sync for kind, and
+// false if no sync has completed yet.
+func (s *Store) LastEndDate(ctx context.Context, kind string) (time.Time, bool, error) {
+	var c cursor
+	err := s.collection.FindOne(ctx, bson.M{"kind": kind}).Decode(&c)
+	if err == mongo.ErrNoDocuments {
+		return time.Time{}, false, nil
+	}
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	return c.EndDate, true, nil
+}
+
+// SetLastEndDate records endDate as the cursor to resume kind's sync from.
+func (s *Store) SetLastEndDate(ctx context.Context, kind string, endDate time.Time) error {
+	_, err := s.collection.UpdateOne(ctx,
+		bson.M{"kind": kind},
+		bson.M{"$set": bson.M{"end_date": endDate}},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
@@ -0,0 +1,37 @@
+package tmdb
+
+import (
+	"fmt"
+	"net/http"
+)
+
+type ChangedID struct {
+	ID    int  `json:"id"`
+	Adult bool `json:"adult"`
+}
+
+type changesResponse struct {
+	Results      []ChangedID `json:"results"`
+	Page         int         `json:"page"`
+	TotalPages   int         `json:"total_pages"`
+	TotalResults int         `json:"total_results"`
+}
+
+// FetchMovieChanges returns every movie ID TMDB reports changed between
+// startDate and endDate (both YYYY-MM-DD), following pagination, for use by
+// an incremental sync instead of rescanning the full daily export.
+func FetchMovieChanges(client http.Client, startDate, endDate string) ([]ChangedID, error) {
+	var all []ChangedID
+	for page := 1; ; page++ {
+		url := fmt.Sprintf("https://api.themoviedb.org/3/movie/changes?start_date=%s&end_date=%s&page=%d&api_key=%s", startDate, endDate, page, APIKey)
+		var resp changesResponse
+		if err := fetchJSON(client, url, &resp); err != nil {
+			return nil, err
+		}
+		all = append(all, resp.Results...)
+		if page >= resp.TotalPages {
+			break
+		}
+	}
+	return all, nil
+}
@@ -0,0 +1,37 @@
+package tmdb
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type Collection struct {
+	ID           int           `json:"id" bson:"id"`
+	Name         string        `json:"name" bson:"name"`
+	Overview     string        `json:"overview" bson:"overview"`
+	PosterPath   string        `json:"poster_path" bson:"poster_path"`
+	BackdropPath string        `json:"backdrop_path" bson:"backdrop_path"`
+	Parts        []interface{} `json:"parts" bson:"parts"`
+	Images       struct {
+		Backdrops []interface{} `json:"backdrops" bson:"backdrops"`
+		Posters   []interface{} `json:"posters" bson:"posters"`
+	} `json:"images" bson:"images"`
+
+	LastSeen time.Time `json:"-" bson:"last_seen"`
+}
+
+func (c *Collection) RecordID() int      { return c.ID }
+func (c *Collection) RecordKind() string { return "collection" }
+func (c *Collection) Touch(at time.Time) { c.LastSeen = at }
+
+// FetchCollection calls TMDB's collection details endpoint, joined with
+// images.
+func FetchCollection(client http.Client, id int) (*Collection, error) {
+	data := &Collection{}
+	url := fmt.Sprintf("https://api.themoviedb.org/3/collection/%d?append_to_response=images&api_key=%s", id, APIKey)
+	if err := fetchJSON(client, url, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
@@ -0,0 +1,102 @@
+package tmdb
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"os"
+	"strings"
+)
+
+// openDatasetFile opens path for reading, transparently decompressing it if
+// it ends in .gz, as TMDB's daily exports do.
+func openDatasetFile(path string) (io.Reader, func() error, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !strings.HasSuffix(path, ".gz") {
+		return file, file.Close, nil
+	}
+	gzReader, err := gzip.NewReader(file)
+	if err != nil {
+		file.Close()
+		return nil, nil, err
+	}
+	return gzReader, func() error {
+		gzReader.Close()
+		return file.Close()
+	}, nil
+}
+
+type DatasetRecord struct {
+	ID            int     `json:"id"`
+	OriginalTitle string  `json:"original_title"`
+	Popularity    float64 `json:"popularity"`
+	Video         bool    `json:"video"`
+	Adult         bool    `json:"adult"`
+}
+
+// ReadDatasetFile reads TMDB's movie_ids daily export, skipping adult titles
+// and any line that fails to parse.
+func ReadDatasetFile(path string) ([]DatasetRecord, error) {
+	reader, closeFile, err := openDatasetFile(path)
+	if err != nil {
+		return nil, err
+	}
+	defer closeFile()
+	var records []DatasetRecord
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		var record DatasetRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			continue
+		}
+		if record.Adult {
+			continue
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// DatasetEntry is the common shape of TMDB's tv_series_ids, person_ids and
+// collection_ids daily exports: enough to decide whether an ID is new or
+// changed without fetching it.
+type DatasetEntry struct {
+	ID         int     `json:"id"`
+	Popularity float64 `json:"popularity"`
+	Adult      bool    `json:"adult"`
+}
+
+func readDatasetEntries(path string) ([]DatasetEntry, error) {
+	reader, closeFile, err := openDatasetFile(path)
+	if err != nil {
+		return nil, err
+	}
+	defer closeFile()
+	var entries []DatasetEntry
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		var entry DatasetEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			continue
+		}
+		if entry.Adult {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// ReadTVDataset reads a tv_series_ids_MM_DD_YYYY.json(.gz) daily export.
+func ReadTVDataset(path string) ([]DatasetEntry, error) { return readDatasetEntries(path) }
+
+// ReadPersonDataset reads a person_ids_MM_DD_YYYY.json(.gz) daily export.
+func ReadPersonDataset(path string) ([]DatasetEntry, error) { return readDatasetEntries(path) }
+
+// ReadCollectionDataset reads a collection_ids_MM_DD_YYYY.json(.gz) daily
+// export.
+func ReadCollectionDataset(path string) ([]DatasetEntry, error) { return readDatasetEntries(path) }
@@ -0,0 +1,121 @@
+package tmdb
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Record is anything a Fetcher can return: enough to file it under the right
+// store collection/table/prefix and the right job queue entry.
+type Record interface {
+	RecordID() int
+	RecordKind() string
+	// Touch records when the entity was last fetched and saved, so the
+	// enqueuer can tell which dataset records have already been picked up
+	// recently without re-fetching them.
+	Touch(t time.Time)
+}
+
+// Fetcher fetches and enumerates one TMDB entity kind. All fetchers share the
+// same rate limiter, retry logic and storage backend; only the endpoint and
+// dataset shape differ.
+type Fetcher interface {
+	// Kind identifies this fetcher, e.g. "movie", "tv", "person", "collection".
+	Kind() string
+	DefaultDatasetFile() string
+	// ReadDatasetIDs returns the IDs to enqueue from a daily export file.
+	ReadDatasetIDs(path string) ([]int, error)
+	Fetch(client http.Client, id int) (Record, error)
+}
+
+type movieFetcher struct{}
+
+func (movieFetcher) Kind() string               { return "movie" }
+func (movieFetcher) DefaultDatasetFile() string { return DatasetFile }
+
+func (movieFetcher) Fetch(c http.Client, id int) (Record, error) { return FetchMovie(c, id) }
+
+func (movieFetcher) ReadDatasetIDs(path string) ([]int, error) {
+	records, err := ReadDatasetFile(path)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int, len(records))
+	for i, r := range records {
+		ids[i] = r.ID
+	}
+	return ids, nil
+}
+
+type tvFetcher struct{}
+
+func (tvFetcher) Kind() string               { return "tv" }
+func (tvFetcher) DefaultDatasetFile() string { return "tv_series_ids_05_23_2024.json" }
+
+func (tvFetcher) Fetch(c http.Client, id int) (Record, error) { return FetchTVSeries(c, id) }
+
+func (tvFetcher) ReadDatasetIDs(path string) ([]int, error) {
+	return readEntryIDs(ReadTVDataset, path)
+}
+
+type personFetcher struct{}
+
+func (personFetcher) Kind() string               { return "person" }
+func (personFetcher) DefaultDatasetFile() string { return "person_ids_05_23_2024.json" }
+
+func (personFetcher) Fetch(c http.Client, id int) (Record, error) { return FetchPerson(c, id) }
+
+func (personFetcher) ReadDatasetIDs(path string) ([]int, error) {
+	return readEntryIDs(ReadPersonDataset, path)
+}
+
+type collectionFetcher struct{}
+
+func (collectionFetcher) Kind() string               { return "collection" }
+func (collectionFetcher) DefaultDatasetFile() string { return "collection_ids_05_23_2024.json" }
+
+func (collectionFetcher) Fetch(c http.Client, id int) (Record, error) {
+	return FetchCollection(c, id)
+}
+
+func (collectionFetcher) ReadDatasetIDs(path string) ([]int, error) {
+	return readEntryIDs(ReadCollectionDataset, path)
+}
+
+func readEntryIDs(read func(string) ([]DatasetEntry, error), path string) ([]int, error) {
+	entries, err := read(path)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int, len(entries))
+	for i, e := range entries {
+		ids[i] = e.ID
+	}
+	return ids, nil
+}
+
+// Fetchers maps the --entity flag value to its Fetcher.
+var Fetchers = map[string]Fetcher{
+	"movie":      movieFetcher{},
+	"tv":         tvFetcher{},
+	"person":     personFetcher{},
+	"collection": collectionFetcher{},
+}
+
+// FetcherFor looks up a Fetcher by kind, or every registered Fetcher when
+// kind is "all".
+func FetcherFor(kind string) ([]Fetcher, error) {
+	if kind == "all" {
+		all := make([]Fetcher, 0, len(Fetchers))
+		for _, f := range Fetchers {
+			all = append(all, f)
+		}
+		return all, nil
+	}
+	f, ok := Fetchers[kind]
+	if !ok {
+		return nil, fmt.Errorf("unknown entity %q", kind)
+	}
+	return []Fetcher{f}, nil
+}
@@ -0,0 +1,71 @@
+package tmdb
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type Movie struct {
+	GenreIds            []int         `json:"genre_ids" bson:"genre_ids"`
+	Adult               bool          `json:"adult" bson:"adult"`
+	BackdropPath        string        `json:"backdrop_path" bson:"backdrop_path"`
+	BelongsToCollection interface{}   `json:"belongs_to_collection" bson:"belongs_to_collection"`
+	Budget              int           `json:"budget" bson:"budget"`
+	Genres              []interface{} `json:"genres" bson:"genres"`
+	Homepage            string        `json:"homepage" bson:"homepage"`
+	ID                  int           `json:"id" bson:"id"`
+	ImdbID              string        `json:"imdb_id" bson:"imdb_id"`
+	OriginalLanguage    string        `json:"original_language" bson:"original_language"`
+	OriginalTitle       string        `json:"original_title" bson:"original_title"`
+	Overview            string        `json:"overview" bson:"overview"`
+	Popularity          float64       `json:"popularity" bson:"popularity"`
+	PosterPath          string        `json:"poster_path" bson:"poster_path"`
+	ProductionCompanies []interface{} `json:"production_companies" bson:"production_companies"`
+	ProductionCountries []interface{} `json:"production_countries" bson:"production_countries"`
+	ReleaseDate         string        `json:"release_date" bson:"release_date"`
+	Revenue             int           `json:"revenue" bson:"revenue"`
+	Runtime             int           `json:"runtime" bson:"runtime"`
+	SpokenLanguages     []interface{} `json:"spoken_languages" bson:"spoken_languages"`
+	Status              string        `json:"status" bson:"status"`
+	Tagline             string        `json:"tagline" bson:"tagline"`
+	Title               string        `json:"title" bson:"title"`
+	Video               bool          `json:"video" bson:"video"`
+	VoteAverage         float64       `json:"vote_average" bson:"vote_average"`
+	VoteCount           int           `json:"vote_count" bson:"vote_count"`
+	Videos              struct {
+		Results []interface{} `json:"results" bson:"results"`
+	} `json:"videos" bson:"videos"`
+	Images struct {
+		Backdrops []interface{} `json:"backdrops" bson:"backdrops"`
+		Logos     []interface{} `json:"logos" bson:"logos"`
+		Posters   []interface{} `json:"posters" bson:"posters"`
+	} `json:"images" bson:"images"`
+	Credits struct {
+		Cast []interface{} `json:"cast" bson:"cast"`
+		Crew []interface{} `json:"crew" bson:"crew"`
+	} `json:"credits" bson:"credits"`
+	Keywords struct {
+		Keywords []interface{} `json:"keywords" bson:"keywords"`
+	} `json:"keywords" bson:"keywords"`
+
+	// LastSeen is set by the worker when the movie is saved, not by TMDB. It
+	// lets the enqueuer tell which dataset records have already been picked
+	// up recently without re-fetching them.
+	LastSeen time.Time `json:"-" bson:"last_seen"`
+}
+
+func (m *Movie) RecordID() int      { return m.ID }
+func (m *Movie) RecordKind() string { return "movie" }
+func (m *Movie) Touch(t time.Time)  { m.LastSeen = t }
+
+// FetchMovie calls TMDB's movie details endpoint, joined with videos, images,
+// credits and keywords.
+func FetchMovie(client http.Client, id int) (*Movie, error) {
+	data := &Movie{}
+	url := fmt.Sprintf("https://api.themoviedb.org/3/movie/%d?language=es-MX,es,en&append_to_response=videos,images,credits,keywords&include_video_language=es-MX,en-US&include_image_language=es-MX,en-US,null&api_key=%s", id, APIKey)
+	if err := fetchJSON(client, url, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
@@ -0,0 +1,46 @@
+package tmdb
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type Person struct {
+	ID                 int     `json:"id" bson:"id"`
+	Name               string  `json:"name" bson:"name"`
+	Adult              bool    `json:"adult" bson:"adult"`
+	Biography          string  `json:"biography" bson:"biography"`
+	Birthday           string  `json:"birthday" bson:"birthday"`
+	Deathday           string  `json:"deathday" bson:"deathday"`
+	PlaceOfBirth       string  `json:"place_of_birth" bson:"place_of_birth"`
+	Popularity         float64 `json:"popularity" bson:"popularity"`
+	KnownForDepartment string  `json:"known_for_department" bson:"known_for_department"`
+	Credits            struct {
+		Cast []interface{} `json:"cast" bson:"cast"`
+		Crew []interface{} `json:"crew" bson:"crew"`
+	} `json:"credits" bson:"credits"`
+	ExternalIds struct {
+		ImdbID string `json:"imdb_id" bson:"imdb_id"`
+	} `json:"external_ids" bson:"external_ids"`
+	Images struct {
+		Profiles []interface{} `json:"profiles" bson:"profiles"`
+	} `json:"images" bson:"images"`
+
+	LastSeen time.Time `json:"-" bson:"last_seen"`
+}
+
+func (p *Person) RecordID() int      { return p.ID }
+func (p *Person) RecordKind() string { return "person" }
+func (p *Person) Touch(at time.Time) { p.LastSeen = at }
+
+// FetchPerson calls TMDB's person details endpoint, joined with credits,
+// external IDs and images.
+func FetchPerson(client http.Client, id int) (*Person, error) {
+	data := &Person{}
+	url := fmt.Sprintf("https://api.themoviedb.org/3/person/%d?append_to_response=credits,external_ids,images&api_key=%s", id, APIKey)
+	if err := fetchJSON(client, url, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
@@ -0,0 +1,65 @@
+// Package tmdb holds the TMDB API client and the data types shared between
+// the enqueuer and the worker.
+package tmdb
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// https://developer.themoviedb.org/docs/daily-id-exports
+
+const APIKey = ""
+const DatasetFile = "movie_ids_05_23_2024.json"
+
+// RateLimitError is returned by a fetch when TMDB responds 429. RetryAfter is
+// the duration parsed from the response's Retry-After header, which falls
+// back to 1 second if the header was absent or unparsable.
+type RateLimitError struct {
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string { return "RateLimitExceededError" }
+
+// ErrNotFound is returned by a fetch when TMDB responds 404, meaning the ID
+// doesn't exist (or was deleted). Unlike RateLimitError and other non-200
+// statuses, this isn't transient, so callers should give up on the job
+// rather than retry it with backoff.
+var ErrNotFound = errors.New("NotFoundError")
+
+// fetchJSON calls url and decodes a 200 response into out, translating
+// TMDB's error statuses into the sentinel errors the retry logic understands.
+func fetchJSON(client http.Client, url string, out interface{}) error {
+	response, err := client.Get(url)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode == http.StatusTooManyRequests {
+		return &RateLimitError{RetryAfter: parseRetryAfter(response.Header.Get("Retry-After"))}
+	}
+	if response.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+	if response.StatusCode != http.StatusOK {
+		return errors.New("UnexpectedError")
+	}
+	return json.NewDecoder(response.Body).Decode(out)
+}
+
+// parseRetryAfter accepts the delay-seconds form of Retry-After (the only
+// form TMDB sends); it falls back to 1 second if the header is missing or
+// malformed.
+func parseRetryAfter(header string) time.Duration {
+	if header == "" {
+		return time.Second
+	}
+	seconds, err := strconv.Atoi(header)
+	if err != nil || seconds < 0 {
+		return time.Second
+	}
+	return time.Duration(seconds) * time.Second
+}
@@ -0,0 +1,27 @@
+package tmdb
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	cases := []struct {
+		name   string
+		header string
+		want   time.Duration
+	}{
+		{"missing header", "", time.Second},
+		{"delay seconds", "30", 30 * time.Second},
+		{"zero", "0", 0},
+		{"negative", "-5", time.Second},
+		{"not a number", "Wed, 21 Oct 2015 07:28:00 GMT", time.Second},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := parseRetryAfter(c.header); got != c.want {
+				t.Errorf("parseRetryAfter(%q) = %v, want %v", c.header, got, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package tmdb
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type TVSeries struct {
+	ID               int           `json:"id" bson:"id"`
+	Name             string        `json:"name" bson:"name"`
+	OriginalName     string        `json:"original_name" bson:"original_name"`
+	Overview         string        `json:"overview" bson:"overview"`
+	FirstAirDate     string        `json:"first_air_date" bson:"first_air_date"`
+	LastAirDate      string        `json:"last_air_date" bson:"last_air_date"`
+	NumberOfEpisodes int           `json:"number_of_episodes" bson:"number_of_episodes"`
+	NumberOfSeasons  int           `json:"number_of_seasons" bson:"number_of_seasons"`
+	Genres           []interface{} `json:"genres" bson:"genres"`
+	Networks         []interface{} `json:"networks" bson:"networks"`
+	Popularity       float64       `json:"popularity" bson:"popularity"`
+	Status           string        `json:"status" bson:"status"`
+	VoteAverage      float64       `json:"vote_average" bson:"vote_average"`
+	VoteCount        int           `json:"vote_count" bson:"vote_count"`
+	AggregateCredits struct {
+		Cast []interface{} `json:"cast" bson:"cast"`
+		Crew []interface{} `json:"crew" bson:"crew"`
+	} `json:"aggregate_credits" bson:"aggregate_credits"`
+	EpisodeGroups struct {
+		Results []interface{} `json:"results" bson:"results"`
+	} `json:"episode_groups" bson:"episode_groups"`
+	ExternalIds struct {
+		ImdbID string `json:"imdb_id" bson:"imdb_id"`
+	} `json:"external_ids" bson:"external_ids"`
+	Videos struct {
+		Results []interface{} `json:"results" bson:"results"`
+	} `json:"videos" bson:"videos"`
+	Images struct {
+		Backdrops []interface{} `json:"backdrops" bson:"backdrops"`
+		Posters   []interface{} `json:"posters" bson:"posters"`
+	} `json:"images" bson:"images"`
+	Keywords struct {
+		Results []interface{} `json:"results" bson:"results"`
+	} `json:"keywords" bson:"keywords"`
+
+	LastSeen time.Time `json:"-" bson:"last_seen"`
+}
+
+func (t *TVSeries) RecordID() int      { return t.ID }
+func (t *TVSeries) RecordKind() string { return "tv" }
+func (t *TVSeries) Touch(at time.Time) { t.LastSeen = at }
+
+// FetchTVSeries calls TMDB's TV details endpoint, joined with the
+// aggregate credits, episode groups, external IDs, videos, images and
+// keywords sub-resources.
+func FetchTVSeries(client http.Client, id int) (*TVSeries, error) {
+	data := &TVSeries{}
+	url := fmt.Sprintf("https://api.themoviedb.org/3/tv/%d?append_to_response=aggregate_credits,episode_groups,external_ids,videos,images,keywords&api_key=%s", id, APIKey)
+	if err := fetchJSON(client, url, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}